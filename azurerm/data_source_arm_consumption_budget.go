@@ -0,0 +1,153 @@
+package azurerm
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/Azure/azure-sdk-for-go/services/consumption/mgmt/2018-10-01/consumption"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmConsumptionBudget() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmConsumptionBudgetRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"scope": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateConsumptionBudgetScopeID,
+			},
+
+			"amount": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+
+			"current_spend": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+
+			"time_grain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"time_period": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"notification": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"threshold": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"operator": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"notification_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"forecast_window": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"contact_emails": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"action_groups": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"contact_roles": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmConsumptionBudgetRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).budgetClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	scope := d.Get("scope").(string)
+
+	var resp consumption.Budget
+	var err error
+	if rgName, ok := consumptionBudgetResourceGroupName(scope); ok {
+		resp, err = client.GetByResourceGroupName(ctx, rgName, name)
+	} else {
+		resp, err = client.Get(ctx, scope, name)
+	}
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Consumption Budget %q was not found at scope %q", name, scope)
+		}
+		return fmt.Errorf("Error reading Consumption Budget %q (Scope %q): %+v", name, scope, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Error reading Consumption Budget %q (Scope %q): ID was nil", name, scope)
+	}
+	d.SetId(*resp.ID)
+
+	if props := resp.BudgetProperties; props != nil {
+		if props.Amount != nil {
+			amount, _ := strconv.ParseFloat(*props.Amount, 64)
+			d.Set("amount", amount)
+		}
+
+		if props.CurrentSpend != nil && props.CurrentSpend.Amount != nil {
+			d.Set("current_spend", *props.CurrentSpend.Amount)
+		}
+
+		if props.TimeGrain != nil {
+			d.Set("time_grain", *props.TimeGrain)
+		}
+
+		if err := d.Set("time_period", flattenAzureRmConsumptionBudgetTimePeriod(props.TimePeriod)); err != nil {
+			return fmt.Errorf("Error setting `time_period`: %+v", err)
+		}
+
+		if err := d.Set("notification", flattenAzureRmConsumptionBudgetNotifications(props.Notifications)); err != nil {
+			return fmt.Errorf("Error setting `notification`: %+v", err)
+		}
+	}
+
+	return nil
+}
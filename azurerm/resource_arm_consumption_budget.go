@@ -3,24 +3,34 @@ package azurerm
 import (
 	"fmt"
 	"log"
-	
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/Azure/azure-sdk-for-go/services/consumption/mgmt/2018-10-01/consumption"
+	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/satori/go.uuid"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
-	"github.com/satori/go.uuid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmConsumptionBudget() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmConsumptionBudgetCreate,
 		Read:   resourceArmConsumptionBudgetRead,
+		Update: resourceArmConsumptionBudgetUpdate,
 		Delete: resourceArmConsumptionBudgetDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceArmConsumptionBudgetCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -28,6 +38,14 @@ func resourceArmConsumptionBudget() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"scope": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateConsumptionBudgetScopeID,
+			},
+
 			"category": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -36,18 +54,16 @@ func resourceArmConsumptionBudget() *schema.Resource {
 					string(consumption.Cost),
 					string(consumption.Usage),
 				}, true),
-			}
+			},
 
 			"amount": {
 				Type:     schema.TypeFloat,
 				Required: true,
-				ForceNew: true,
 			},
 
 			"time_grain": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 				ValidateFunc: validation.StringInSlice([]string{
 					string(consumption.TimeGrainTypeAnnually),
 					string(consumption.TimeGrainTypeMonthly),
@@ -56,16 +72,45 @@ func resourceArmConsumptionBudget() *schema.Resource {
 				DiffSuppressFunc: suppress.CaseDifference,
 			},
 
+			"time_period": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start_date": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateFunc:     validation.IsRFC3339Time,
+							DiffSuppressFunc: suppress.RFC3339Time,
+						},
+						"end_date": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateFunc:     validation.IsRFC3339Time,
+							DiffSuppressFunc: suppress.RFC3339Time,
+						},
+					},
+				},
+			},
+
 			"filters": {
 				Type:     schema.TypeList,
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
+				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"meters": {
-							Type:     schema.TypeString,
+							Type:     schema.TypeList,
 							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.UUID,
+							},
 						},
 						"resource_group_names": {
 							Type:     schema.TypeList,
@@ -75,16 +120,57 @@ func resourceArmConsumptionBudget() *schema.Resource {
 						"resource_ids": {
 							Type:     schema.TypeList,
 							Optional: true,
-							Elem: 	  &schema.Schema{
-								Type: 		  schema.TypeString,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
 								ValidateFunc: azure.ValidateResourceID,
 							},
 						},
 						"tags": {
 							Type:     schema.TypeList,
 							Optional: true,
-							Elem: &schema.Schema{
-								Type: schema.TypeString,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"operator": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(consumption.In),
+										}, true),
+									},
+									"values": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"dimensions": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"operator": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(consumption.In),
+										}, true),
+									},
+									"values": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
 							},
 						},
 					},
@@ -99,8 +185,8 @@ func resourceArmConsumptionBudget() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"threshold": {
-							Type:     schema.TypeInt,
-							Required: true,
+							Type:         schema.TypeInt,
+							Required:     true,
 							ValidateFunc: validation.IntBetween(0, 1000),
 						},
 						"operator": {
@@ -112,40 +198,176 @@ func resourceArmConsumptionBudget() *schema.Resource {
 								string(consumption.GreaterThanOrEqualTo),
 							}, true),
 						},
+						"notification_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(consumption.Actual),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(consumption.Actual),
+								string(consumption.Forecasted),
+							}, false),
+						},
+						"forecast_window": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.ISO8601Duration,
+						},
 						"action_groups": {
 							Type:     schema.TypeList,
-							Optional: true;
-							Elem: &schema.Schema{Type: schema.TypeString},
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateConsumptionBudgetActionGroupID,
+							},
 						},
 						"contact_emails": {
 							Type:     schema.TypeList,
 							Optional: true,
-							Elem: &schema.Schema{Type: schema.TypeString},
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"contact_roles": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									"Owner",
+									"Contributor",
+									"Reader",
+								}, false),
+							},
 						},
-					}
+					},
 				},
 			},
+
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
 
+// resourceArmConsumptionBudgetCustomizeDiff rejects Forecasted notifications on
+// time grains the Budgets API can't project a forecast over - annual budgets
+// don't carry enough history within the grain for the API to compute one. It
+// also rejects a `time_period.0.start_date` that does not fall on the period
+// boundary of the configured time grain.
+func resourceArmConsumptionBudgetCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	timeGrain := d.Get("time_grain").(string)
+
+	if timePeriods := d.Get("time_period").([]interface{}); len(timePeriods) > 0 {
+		timePeriod := timePeriods[0].(map[string]interface{})
+		startDate := timePeriod["start_date"].(string)
+
+		if startDate != "" {
+			if err := validateConsumptionBudgetTimePeriodStartDate(startDate, timeGrain); err != nil {
+				return err
+			}
+		}
+	}
+
+	notifications := d.Get("notification").([]interface{})
+	for _, raw := range notifications {
+		notification := raw.(map[string]interface{})
+		notificationType := notification["notification_type"].(string)
+
+		if notificationType == string(consumption.Forecasted) {
+			if !strings.EqualFold(timeGrain, string(consumption.TimeGrainTypeMonthly)) && !strings.EqualFold(timeGrain, string(consumption.TimeGrainTypeQuarterly)) {
+				return fmt.Errorf("`notification_type` can only be `Forecasted` when `time_grain` is `Monthly` or `Quarterly`, got %q", timeGrain)
+			}
+		}
+	}
+
+	return nil
+}
+
+// consumptionBudgetScopeRegex matches the scope shapes the Consumption
+// Budgets API accepts: a subscription, a resource group, a management
+// group, or a billing account, each optionally rooted at a leading slash.
+var consumptionBudgetScopeRegex = regexp.MustCompile(`(?i)^/?subscriptions/[^/]+(/resourceGroups/[^/]+)?$|(?i)^/?providers/Microsoft\.Management/managementGroups/[^/]+$|(?i)^/?providers/Microsoft\.Billing/billingAccounts/[^/]+$`)
+
+// validateConsumptionBudgetScopeID validates that the given value is one of
+// the scope shapes the Consumption Budgets API accepts. azure.ValidateResourceID
+// can't be used here since it requires a subscription-rooted resource ID,
+// which rejects management group and billing account scopes.
+func validateConsumptionBudgetScopeID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if !consumptionBudgetScopeRegex.MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be a subscription, resource group, management group, or billing account ID, got %q", k, v))
+	}
+
+	return warnings, errors
+}
+
+// consumptionBudgetScope resolves the scope a budget should be created against:
+// an explicit subscription, management group, resource group or billing
+// account ID passed via `scope`, or the provider's own subscription when
+// `scope` is unset, preserving the pre-multi-scope behaviour.
+func consumptionBudgetScope(d *schema.ResourceData, client *ArmClient) (string, error) {
+	if v, ok := d.GetOk("scope"); ok {
+		scope := v.(string)
+		if _, errs := validateConsumptionBudgetScopeID(scope, "scope"); len(errs) > 0 {
+			return "", fmt.Errorf("`scope` is not a valid scope ID: %+v", errs[0])
+		}
+		return scope, nil
+	}
+
+	return fmt.Sprintf("subscriptions/%s", client.subscriptionId), nil
+}
+
+// consumptionBudgetResourceGroupRegex extracts the resource group name from a
+// resource-group-scoped `scope` value, e.g. "subscriptions/{id}/resourceGroups/{name}".
+var consumptionBudgetResourceGroupRegex = regexp.MustCompile(`(?i)^/?subscriptions/[^/]+/resourceGroups/([^/]+)$`)
+
+// consumptionBudgetResourceGroupName returns the resource group name encoded
+// in scope and whether scope is resource-group-shaped. The Budgets API has a
+// resource-group-specific client method for that scope shape; subscription,
+// management group, and billing account scopes all go through the generic
+// scope-based methods instead.
+func consumptionBudgetResourceGroupName(scope string) (string, bool) {
+	matches := consumptionBudgetResourceGroupRegex.FindStringSubmatch(scope)
+	if matches == nil {
+		return "", false
+	}
+
+	return matches[1], true
+}
+
 func resourceArmConsumptionBudgetCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).budgetClient
-	ctx := meta.(*ArmClient).StopContext
+	armClient := meta.(*ArmClient)
+	ctx := armClient.StopContext
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Budget creation.")
 
 	name := d.Get("name").(string)
 	category := d.Get("category").(string)
-	amount := d.Get("amount").(string)
+	amount := strconv.FormatFloat(d.Get("amount").(float64), 'f', -1, 64)
 	timeGrain := d.Get("time_grain").(string)
 
+	scope, err := consumptionBudgetScope(d, armClient)
+	if err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] creating Consumption Budget %q at scope %q", name, scope)
+
 	properties := consumption.BudgetProperties{
-		Category: &category,
-		Amount: &amount,
+		Category:  &category,
+		Amount:    &amount,
 		TimeGrain: &timeGrain,
 	}
 
+	if _, ok := d.GetOk("time_period"); ok {
+		properties.TimePeriod = expandAzureRmConsumptionBudgetTimePeriod(d)
+	}
+
 	if _, ok := d.GetOk("filters"); ok {
 		filters, err := expandAzureRmConsumptionBudgetFilters(d)
 		if err != nil {
@@ -161,44 +383,440 @@ func resourceArmConsumptionBudgetCreate(d *schema.ResourceData, meta interface{}
 		}
 		properties.Notifications = notifications
 	}
+
+	budget := consumption.Budget{
+		Name:             &name,
+		BudgetProperties: &properties,
+	}
+
+	var resp consumption.Budget
+	if rgName, ok := consumptionBudgetResourceGroupName(scope); ok {
+		resp, err = client.CreateOrUpdateByResourceGroupName(ctx, rgName, name, budget)
+	} else {
+		resp, err = client.CreateOrUpdate(ctx, scope, name, budget)
+	}
+	if err != nil {
+		return fmt.Errorf("Error creating Consumption Budget %q (Scope %q): %+v", name, scope, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Error creating Consumption Budget %q (Scope %q): ID was nil", name, scope)
+	}
+	d.SetId(*resp.ID)
+
+	return resourceArmConsumptionBudgetRead(d, meta)
+}
+
+func resourceArmConsumptionBudgetUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).budgetClient
+	armClient := meta.(*ArmClient)
+	ctx := armClient.StopContext
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Budget update.")
+
+	name := d.Get("name").(string)
+	category := d.Get("category").(string)
+	amount := strconv.FormatFloat(d.Get("amount").(float64), 'f', -1, 64)
+	timeGrain := d.Get("time_grain").(string)
+	eTag := d.Get("etag").(string)
+
+	scope, err := consumptionBudgetScope(d, armClient)
+	if err != nil {
+		return err
+	}
+
+	properties := consumption.BudgetProperties{
+		Category:  &category,
+		Amount:    &amount,
+		TimeGrain: &timeGrain,
+	}
+
+	if _, ok := d.GetOk("time_period"); ok {
+		properties.TimePeriod = expandAzureRmConsumptionBudgetTimePeriod(d)
+	}
+
+	if _, ok := d.GetOk("filters"); ok {
+		filters, err := expandAzureRmConsumptionBudgetFilters(d)
+		if err != nil {
+			return err
+		}
+		properties.Filters = filters
+	}
+
+	if _, ok := d.GetOk("notification"); ok {
+		notifications, err := expandAzureRmConsumptionBudgetNotifications(d)
+		if err != nil {
+			return err
+		}
+		properties.Notifications = notifications
+	}
+
+	// the budgets API requires the current ETag on update so a change made
+	// out-of-band (e.g. another operator editing the same budget) is caught
+	// as a conflict rather than silently clobbered
+	budget := consumption.Budget{
+		Name:             &name,
+		ETag:             &eTag,
+		BudgetProperties: &properties,
+	}
+
+	if rgName, ok := consumptionBudgetResourceGroupName(scope); ok {
+		_, err = client.CreateOrUpdateByResourceGroupName(ctx, rgName, name, budget)
+	} else {
+		_, err = client.CreateOrUpdate(ctx, scope, name, budget)
+	}
+	if err != nil {
+		return fmt.Errorf("Error updating Consumption Budget %q (Scope %q): %+v", name, scope, err)
+	}
+
+	return resourceArmConsumptionBudgetRead(d, meta)
+}
+
+func resourceArmConsumptionBudgetRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).budgetClient
+	ctx := meta.(*ArmClient).StopContext
+
+	scope, name, err := parseConsumptionBudgetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	var resp consumption.Budget
+	if rgName, ok := consumptionBudgetResourceGroupName(scope); ok {
+		resp, err = client.GetByResourceGroupName(ctx, rgName, name)
+	} else {
+		resp, err = client.Get(ctx, scope, name)
+	}
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Consumption Budget %q (Scope %q) was not found - removing from state", name, scope)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Consumption Budget %q (Scope %q): %+v", name, scope, err)
+	}
+
+	d.Set("name", name)
+	d.Set("scope", scope)
+
+	if resp.ETag != nil {
+		d.Set("etag", *resp.ETag)
+	}
+
+	if props := resp.BudgetProperties; props != nil {
+		if props.Category != nil {
+			d.Set("category", *props.Category)
+		}
+
+		if props.Amount != nil {
+			amount, err := strconv.ParseFloat(*props.Amount, 64)
+			if err != nil {
+				return fmt.Errorf("Error parsing `amount` %q: %+v", *props.Amount, err)
+			}
+			d.Set("amount", amount)
+		}
+
+		if props.TimeGrain != nil {
+			d.Set("time_grain", *props.TimeGrain)
+		}
+
+		if err := d.Set("time_period", flattenAzureRmConsumptionBudgetTimePeriod(props.TimePeriod)); err != nil {
+			return fmt.Errorf("Error setting `time_period`: %+v", err)
+		}
+
+		if err := d.Set("filters", flattenAzureRmConsumptionBudgetFilters(props.Filters)); err != nil {
+			return fmt.Errorf("Error setting `filters`: %+v", err)
+		}
+
+		if err := d.Set("notification", flattenAzureRmConsumptionBudgetNotifications(props.Notifications)); err != nil {
+			return fmt.Errorf("Error setting `notification`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmConsumptionBudgetDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).budgetClient
+	ctx := meta.(*ArmClient).StopContext
+
+	scope, name, err := parseConsumptionBudgetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if rgName, ok := consumptionBudgetResourceGroupName(scope); ok {
+		_, err = client.DeleteByResourceGroupName(ctx, rgName, name)
+	} else {
+		_, err = client.Delete(ctx, scope, name)
+	}
+	if err != nil {
+		return fmt.Errorf("Error deleting Consumption Budget %q (Scope %q): %+v", name, scope, err)
+	}
+
+	return nil
 }
 
+// parseConsumptionBudgetID splits a Budget resource ID into the scope it was
+// created against and the budget name, the inverse of the `{scope}/providers/
+// Microsoft.Consumption/budgets/{name}` ID the Budgets API returns.
+func parseConsumptionBudgetID(id string) (scope string, name string, err error) {
+	const marker = "/providers/Microsoft.Consumption/budgets/"
 
+	idx := strings.Index(id, marker)
+	if idx < 0 {
+		return "", "", fmt.Errorf("%q is not a valid Consumption Budget ID", id)
+	}
 
+	scope = id[:idx]
+	name = id[idx+len(marker):]
+	if scope == "" || name == "" {
+		return "", "", fmt.Errorf("%q is not a valid Consumption Budget ID", id)
+	}
 
-func expandAzureRmConsumptionBudgetFilters(d *Schema.ResourceData) ([]consumption.Filters, error) {
-	filtersConfig := d.Get("filters").(*schema.Set).List()
+	return scope, name, nil
+}
+
+// validateConsumptionBudgetTimePeriodStartDate ensures startDate lands on the
+// period boundary the Budgets API requires for the given time grain: the
+// first of the month for Monthly, a quarter boundary for Quarterly, and a
+// year boundary for Annually.
+func validateConsumptionBudgetTimePeriodStartDate(startDate string, timeGrain string) error {
+	t, err := time.Parse(time.RFC3339, startDate)
+	if err != nil {
+		return fmt.Errorf("`time_period.0.start_date` is not a valid RFC3339 date: %+v", err)
+	}
+
+	if t.Day() != 1 {
+		return fmt.Errorf("`time_period.0.start_date` must fall on the first day of a period, got %q", startDate)
+	}
+
+	switch strings.ToLower(timeGrain) {
+	case strings.ToLower(string(consumption.TimeGrainTypeQuarterly)):
+		if (t.Month()-1)%3 != 0 {
+			return fmt.Errorf("`time_period.0.start_date` must fall on a quarter boundary (Jan/Apr/Jul/Oct) when `time_grain` is `Quarterly`, got %q", startDate)
+		}
+	case strings.ToLower(string(consumption.TimeGrainTypeAnnually)):
+		if t.Month() != time.January {
+			return fmt.Errorf("`time_period.0.start_date` must fall on a year boundary (January) when `time_grain` is `Annually`, got %q", startDate)
+		}
+	}
+
+	return nil
+}
+
+func expandAzureRmConsumptionBudgetTimePeriod(d *schema.ResourceData) *consumption.BudgetTimePeriod {
+	timePeriods := d.Get("time_period").([]interface{})
+	if len(timePeriods) == 0 || timePeriods[0] == nil {
+		return nil
+	}
+
+	timePeriod := timePeriods[0].(map[string]interface{})
+
+	timePeriodProperties := consumption.BudgetTimePeriod{}
+
+	if startDate := timePeriod["start_date"].(string); startDate != "" {
+		t, _ := time.Parse(time.RFC3339, startDate)
+		timePeriodProperties.StartDate = &date.Time{Time: t}
+	}
+
+	if endDate := timePeriod["end_date"].(string); endDate != "" {
+		t, _ := time.Parse(time.RFC3339, endDate)
+		timePeriodProperties.EndDate = &date.Time{Time: t}
+	}
+
+	return &timePeriodProperties
+}
+
+func flattenAzureRmConsumptionBudgetTimePeriod(timePeriod *consumption.BudgetTimePeriod) []interface{} {
+	if timePeriod == nil {
+		return []interface{}{}
+	}
+
+	result := make(map[string]interface{})
+
+	if timePeriod.StartDate != nil {
+		result["start_date"] = timePeriod.StartDate.Format(time.RFC3339)
+	}
+
+	if timePeriod.EndDate != nil {
+		result["end_date"] = timePeriod.EndDate.Format(time.RFC3339)
+	}
+
+	return []interface{}{result}
+}
+
+func expandAzureRmConsumptionBudgetFilters(d *schema.ResourceData) (*consumption.Filters, error) {
+	filtersConfig := d.Get("filters").([]interface{})
+	if len(filtersConfig) == 0 || filtersConfig[0] == nil {
+		return nil, nil
+	}
 	filterConfig := filtersConfig[0].(map[string]interface{})
-	filter := &consumption.Filters
 
-	if r, ok := filterConfig["meters"].(*schema.Set); ok && r.Len() > 0 {
-		var meters []uuid.UUID
-		for _, v := range r.List() {
-			s := v.(uuid.UUID)
-			meters = append(meters, s)
+	filter := consumption.Filters{}
+
+	if v, ok := filterConfig["meters"].([]interface{}); ok && len(v) > 0 {
+		meters := make([]uuid.UUID, 0, len(v))
+		for _, raw := range v {
+			id, err := uuid.FromString(raw.(string))
+			if err != nil {
+				return nil, fmt.Errorf("`filters.0.meters` contains an invalid UUID %q: %+v", raw, err)
+			}
+			meters = append(meters, id)
+		}
+		filter.Meters = &meters
+	}
+
+	if v, ok := filterConfig["resource_group_names"].([]interface{}); ok && len(v) > 0 {
+		rgNames := make([]string, 0, len(v))
+		for _, raw := range v {
+			rgNames = append(rgNames, raw.(string))
+		}
+		filter.ResourceGroups = &rgNames
+	}
+
+	if v, ok := filterConfig["resource_ids"].([]interface{}); ok && len(v) > 0 {
+		resourceIds := make([]string, 0, len(v))
+		for _, raw := range v {
+			resourceIds = append(resourceIds, raw.(string))
+		}
+		filter.Resources = &resourceIds
+	}
+
+	if v, ok := filterConfig["tags"].([]interface{}); ok && len(v) > 0 {
+		tags, err := expandAzureRmConsumptionBudgetFilterExpressions(v)
+		if err != nil {
+			return nil, err
+		}
+		filter.Tags = tags
+	}
+
+	if v, ok := filterConfig["dimensions"].([]interface{}); ok && len(v) > 0 {
+		dimensions, err := expandAzureRmConsumptionBudgetFilterExpressions(v)
+		if err != nil {
+			return nil, err
 		}
-		filter.Meters = meters
+		filter.Dimensions = dimensions
 	}
 
-	if r, ok := filterConfig["resource_group_names"].(*schema.Set); ok && r.Len() > 0 {
-		var rgNames []string
-		for _, v := range r.List() {
-			s := v.(string)
-			rgNames = append(rgNames, s)
+	return &filter, nil
+}
+
+// expandAzureRmConsumptionBudgetFilterExpressions expands the `tags` and
+// `dimensions` sub-blocks, which share the same name/operator/values shape.
+func expandAzureRmConsumptionBudgetFilterExpressions(raw []interface{}) (*[]consumption.BudgetComparisonExpression, error) {
+	expressions := make([]consumption.BudgetComparisonExpression, 0, len(raw))
+
+	for _, item := range raw {
+		config := item.(map[string]interface{})
+
+		name := config["name"].(string)
+		operator := config["operator"].(string)
+
+		valuesConfig := config["values"].([]interface{})
+		values := make([]string, 0, len(valuesConfig))
+		for _, v := range valuesConfig {
+			values = append(values, v.(string))
 		}
-		filter.ResourceGroups = rgNames
+
+		expressions = append(expressions, consumption.BudgetComparisonExpression{
+			Name:     &name,
+			Operator: &operator,
+			Values:   &values,
+		})
+	}
+
+	return &expressions, nil
+}
+
+func flattenAzureRmConsumptionBudgetFilters(filters *consumption.Filters) []interface{} {
+	if filters == nil {
+		return []interface{}{}
 	}
 
-	if r, ok := filterConfig["resource_ids"].(*schema.Set); ok && r.Len() > 0 {
-		var resourceIds []string
-		for _, v := range r.List() {
-			s := v.(string)
-			resourceIds = append(resourceIds, s)
+	result := make(map[string]interface{})
+
+	if filters.Meters != nil {
+		meters := make([]string, 0, len(*filters.Meters))
+		for _, m := range *filters.Meters {
+			meters = append(meters, m.String())
 		}
-		filter.resourceIds = resourceIds
+		result["meters"] = meters
+	}
+
+	if filters.ResourceGroups != nil {
+		result["resource_group_names"] = *filters.ResourceGroups
+	}
+
+	if filters.Resources != nil {
+		result["resource_ids"] = *filters.Resources
 	}
 
-	return filter, nil
+	if filters.Tags != nil {
+		result["tags"] = flattenAzureRmConsumptionBudgetFilterExpressions(filters.Tags)
+	}
+
+	if filters.Dimensions != nil {
+		result["dimensions"] = flattenAzureRmConsumptionBudgetFilterExpressions(filters.Dimensions)
+	}
+
+	return []interface{}{result}
+}
+
+func flattenAzureRmConsumptionBudgetFilterExpressions(expressions *[]consumption.BudgetComparisonExpression) []interface{} {
+	if expressions == nil {
+		return []interface{}{}
+	}
+
+	result := make([]interface{}, 0, len(*expressions))
+	for _, expression := range *expressions {
+		item := make(map[string]interface{})
+		if expression.Name != nil {
+			item["name"] = *expression.Name
+		}
+		if expression.Operator != nil {
+			item["operator"] = *expression.Operator
+		}
+		if expression.Values != nil {
+			item["values"] = *expression.Values
+		}
+		result = append(result, item)
+	}
+
+	return result
+}
+
+// validateConsumptionBudgetActionGroupID confirms the given value is a
+// resource ID for an Azure Monitor Action Group, since the Budgets API
+// accepts `action_groups` entries of that resource type only.
+func validateConsumptionBudgetActionGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if v == "" {
+		errors = append(errors, fmt.Errorf("%q must not be empty", k))
+		return warnings, errors
+	}
+
+	if _, errs := azure.ValidateResourceID(v, k); len(errs) > 0 {
+		errors = append(errors, errs...)
+		return warnings, errors
+	}
+
+	id, err := azure.ParseAzureResourceID(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid resource ID: %+v", k, err))
+		return warnings, errors
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Insights") || len(id.Path["actionGroups"]) == 0 {
+		errors = append(errors, fmt.Errorf("%q must be the resource ID of an Azure Monitor Action Group (Microsoft.Insights/actionGroups), got %q", k, v))
+	}
+
+	return warnings, errors
 }
 
 func expandAzureRmConsumptionBudgetNotifications(d *schema.ResourceData) ([]consumption.Notification, error) {
@@ -210,54 +828,75 @@ func expandAzureRmConsumptionBudgetNotifications(d *schema.ResourceData) ([]cons
 
 		threshold := int32(config["threshold"].(int))
 		operator := config["operator"].(string)
+		thresholdType := consumption.ThresholdType(config["notification_type"].(string))
 
 		properties := consumption.Notification{
-			Threshold: &threshold,
-			Operator: &operator,
+			Threshold:     &threshold,
+			Operator:      &operator,
+			ThresholdType: thresholdType,
+		}
+
+		if forecastWindow := config["forecast_window"].(string); forecastWindow != "" {
+			properties.ForecastWindow = &forecastWindow
 		}
 
-		if r, ok := config["contact_emails"].(*schema.Set); ok && r.Len() > 0 {
+		if r, ok := config["contact_emails"].([]interface{}); ok && len(r) > 0 {
 			var contactEmails []string
-			for _, v := range r.List() {
+			for _, v := range r {
 				s := v.(string)
 				contactEmails = append(contactEmails, s)
 			}
 			properties.ContactEmails = &contactEmails
 		}
 
-		if r, ok := config["action_groups"].(*schema.Set); ok && r.Len() > 0 {
+		if r, ok := config["action_groups"].([]interface{}); ok && len(r) > 0 {
 			var actionGroups []string
-			for _, v := range r.List() {
+			for _, v := range r {
 				s := v.(string)
 				actionGroups = append(actionGroups, s)
 			}
 			properties.ContactGroups = &actionGroups
 		}
 
+		if r, ok := config["contact_roles"].([]interface{}); ok && len(r) > 0 {
+			var contactRoles []string
+			for _, v := range r {
+				s := v.(string)
+				contactRoles = append(contactRoles, s)
+			}
+			properties.ContactRoles = &contactRoles
+		}
+
 		managed_notifications = append(managed_notifications, properties)
 	}
 
 	return managed_notifications, nil
 }
 
-
-
 func flattenAzureRmConsumptionBudgetNotifications(notifications *[]consumption.Notification) []map[string]interface{} {
 	if notifications == nil {
-		return []interface{}{}
+		return []map[string]interface{}{}
 	}
 
 	result := make([]map[string]interface{}, 0)
-	for _, notification := range notifications {
+	for _, notification := range *notifications {
 		notificationConfig := make(map[string]interface{})
 		notificationConfig["threshold"] = *notification.Threshold
 		notificationConfig["operator"] = *notification.Operator
+		notificationConfig["notification_type"] = string(notification.ThresholdType)
+
+		if notification.ForecastWindow != nil {
+			notificationConfig["forecast_window"] = *notification.ForecastWindow
+		}
 
-		if emails := notificationConfig["contact_emails"]; emails != nil {
-			notificationConfig["contact_emails"] = sliceToSet(*notification.ContactEmails)
+		if notification.ContactEmails != nil {
+			notificationConfig["contact_emails"] = *notification.ContactEmails
+		}
+		if notification.ContactGroups != nil {
+			notificationConfig["action_groups"] = *notification.ContactGroups
 		}
-		if actionGroups := notificationConfig["action_groups"]; actionGroups != nil {
-			notificationConfig["action_groups"] = sliceToSet(*notification.ContactGroups)
+		if notification.ContactRoles != nil {
+			notificationConfig["contact_roles"] = *notification.ContactRoles
 		}
 
 		result = append(result, notificationConfig)
@@ -265,13 +904,3 @@ func flattenAzureRmConsumptionBudgetNotifications(notifications *[]consumption.N
 
 	return result
 }
-
-func sliceToSet(slice []string) *schema.Set {
-	set := &schema.Set{F: schema.HashString}
-	for _, v := range slice {
-		set.Add(v)
-	}
-	return set
-}
-
-func validateFilter(filterConfig map[string]interface{}, )
\ No newline at end of file
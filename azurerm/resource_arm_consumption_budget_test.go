@@ -0,0 +1,188 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMConsumptionBudget_updateThreshold(t *testing.T) {
+	resourceName := "azurerm_consumption_budget.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMConsumptionBudgetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMConsumptionBudget_withThreshold(ri, 80),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMConsumptionBudgetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "notification.0.threshold", "80"),
+				),
+			},
+			{
+				Config: testAccAzureRMConsumptionBudget_withThreshold(ri, 95),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMConsumptionBudgetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "notification.0.threshold", "95"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMConsumptionBudget_updateAmount(t *testing.T) {
+	resourceName := "azurerm_consumption_budget.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMConsumptionBudgetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMConsumptionBudget_withAmount(ri, 1000),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMConsumptionBudgetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "amount", "1000"),
+				),
+			},
+			{
+				Config: testAccAzureRMConsumptionBudget_withAmount(ri, 2500),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMConsumptionBudgetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "amount", "2500"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMConsumptionBudget_addRemoveNotification(t *testing.T) {
+	resourceName := "azurerm_consumption_budget.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMConsumptionBudgetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMConsumptionBudget_noNotification(ri),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMConsumptionBudgetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "notification.#", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMConsumptionBudget_withThreshold(ri, 80),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMConsumptionBudgetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "notification.#", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMConsumptionBudget_noNotification(ri),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMConsumptionBudgetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "notification.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMConsumptionBudgetExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Consumption Budget not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+
+		client := testAccProvider.Meta().(*ArmClient).budgetClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		scope := fmt.Sprintf("subscriptions/%s", testAccProvider.Meta().(*ArmClient).subscriptionId)
+
+		resp, err := client.Get(ctx, scope, name)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on budgetClient: %+v", err)
+		}
+
+		if resp.StatusCode == 404 {
+			return fmt.Errorf("Bad: Consumption Budget %q does not exist", name)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMConsumptionBudgetDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).budgetClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_consumption_budget" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		scope := fmt.Sprintf("subscriptions/%s", testAccProvider.Meta().(*ArmClient).subscriptionId)
+
+		resp, err := client.Get(ctx, scope, name)
+		if err != nil {
+			if resp.StatusCode == 404 {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("Consumption Budget %q still exists", name)
+	}
+
+	return nil
+}
+
+func testAccAzureRMConsumptionBudget_withThreshold(rInt int, threshold int) string {
+	return fmt.Sprintf(`
+resource "azurerm_consumption_budget" "test" {
+  name     = "acctestbudget-%d"
+  category = "Cost"
+  amount   = 1000
+  time_grain = "Monthly"
+
+  notification {
+    threshold = %d
+    operator  = "GreaterThan"
+  }
+}
+`, rInt, threshold)
+}
+
+func testAccAzureRMConsumptionBudget_withAmount(rInt int, amount int) string {
+	return fmt.Sprintf(`
+resource "azurerm_consumption_budget" "test" {
+  name     = "acctestbudget-%d"
+  category = "Cost"
+  amount   = %d
+  time_grain = "Monthly"
+}
+`, rInt, amount)
+}
+
+func testAccAzureRMConsumptionBudget_noNotification(rInt int) string {
+	return fmt.Sprintf(`
+resource "azurerm_consumption_budget" "test" {
+  name     = "acctestbudget-%d"
+  category = "Cost"
+  amount   = 1000
+  time_grain = "Monthly"
+}
+`, rInt)
+}